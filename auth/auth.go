@@ -0,0 +1,181 @@
+// Package auth adds OIDC/OAuth2 authentication to the ucode_sdk client.
+//
+// A Provider wraps an oauth2.TokenSource so the SDK can authenticate to
+// ucode.io using an external identity provider (Keycloak, Auth0, Google,
+// or any OIDC-compliant issuer) instead of a static app-id/token pair.
+// Tokens are refreshed transparently and a Provider can notify listeners
+// whenever the underlying token rotates, so long-lived connections such
+// as MQTT connections can reconnect with the new token.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Config configures an OIDC-backed Provider.
+type Config struct {
+	// IssuerURL is the OIDC discovery issuer, e.g. https://accounts.google.com.
+	IssuerURL string
+
+	ClientID     string
+	ClientSecret string
+
+	// Scopes requested in addition to "openid".
+	Scopes []string
+
+	// RedirectURL is required when using the authorization code grant.
+	RedirectURL string
+}
+
+// Provider authenticates against an OIDC issuer and exposes the resulting
+// tokens as an oauth2.TokenSource, refreshing them as needed.
+type Provider struct {
+	cfg      Config
+	oauthCfg oauth2.Config
+	verifier *oidc.IDTokenVerifier
+
+	mu        sync.Mutex
+	source    oauth2.TokenSource
+	listeners []func(*oauth2.Token)
+}
+
+// NewProvider performs OIDC discovery against cfg.IssuerURL and returns a
+// Provider ready to exchange authorization codes or client credentials.
+func NewProvider(ctx context.Context, cfg Config) (*Provider, error) {
+	provider, err := oidc.NewProvider(ctx, cfg.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("auth: oidc discovery failed: %w", err)
+	}
+
+	oauthCfg := oauth2.Config{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		RedirectURL:  cfg.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       append([]string{oidc.ScopeOpenID}, cfg.Scopes...),
+	}
+
+	return &Provider{
+		cfg:      cfg,
+		oauthCfg: oauthCfg,
+		verifier: provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+	}, nil
+}
+
+// AuthCodeURL returns the URL to redirect a user to for the authorization
+// code grant.
+func (p *Provider) AuthCodeURL(state string) string {
+	return p.oauthCfg.AuthCodeURL(state)
+}
+
+// ExchangeCode exchanges an authorization code for a token and installs
+// a TokenSource that renews it on demand: the token is refreshed when
+// TokenSource().Token() is called after it has expired. No background
+// goroutine refreshes it.
+func (p *Provider) ExchangeCode(ctx context.Context, code string) (*oauth2.Token, error) {
+	tok, err := p.oauthCfg.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("auth: code exchange failed: %w", err)
+	}
+	p.setSource(ctx, p.oauthCfg.TokenSource(ctx, tok))
+	return tok, nil
+}
+
+// ClientCredentials obtains a token via the client-credentials grant,
+// suitable for machine-to-machine SDK usage with no interactive user.
+func (p *Provider) ClientCredentials(ctx context.Context) (*oauth2.Token, error) {
+	ccCfg := clientcredentials.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		TokenURL:     p.oauthCfg.Endpoint.TokenURL,
+		Scopes:       p.oauthCfg.Scopes,
+	}
+	src := ccCfg.TokenSource(ctx)
+	tok, err := src.Token()
+	if err != nil {
+		return nil, fmt.Errorf("auth: client credentials grant failed: %w", err)
+	}
+	p.setSource(ctx, src)
+	return tok, nil
+}
+
+// WithTokenSource lets a caller plug in their own oauth2.TokenSource
+// (e.g. from golang.org/x/oauth2/google) instead of the built-in OIDC flow.
+func (p *Provider) WithTokenSource(ctx context.Context, src oauth2.TokenSource) {
+	p.setSource(ctx, src)
+}
+
+// TokenSource returns the current oauth2.TokenSource. It is safe to call
+// concurrently and always reflects the most recently configured source.
+func (p *Provider) TokenSource() oauth2.TokenSource {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.source
+}
+
+// OnRotate registers a callback invoked whenever a fresh token is minted,
+// so callers (e.g. the MQTT transport) can re-authenticate connections.
+func (p *Provider) OnRotate(fn func(*oauth2.Token)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.listeners = append(p.listeners, fn)
+}
+
+func (p *Provider) setSource(ctx context.Context, src oauth2.TokenSource) {
+	ns := &notifyingSource{ctx: ctx, base: src, onRotate: p.notify}
+	p.mu.Lock()
+	p.source = ns
+	listeners := p.listeners
+	p.mu.Unlock()
+
+	if len(listeners) == 0 {
+		return
+	}
+	// Fetch through ns so it records the token it reports; later calls
+	// then do not announce the same token as a rotation a second time.
+	_, _ = ns.Token()
+}
+
+func (p *Provider) notify(tok *oauth2.Token) {
+	p.mu.Lock()
+	listeners := append([]func(*oauth2.Token){}, p.listeners...)
+	p.mu.Unlock()
+
+	for _, fn := range listeners {
+		fn(tok)
+	}
+}
+
+// notifyingSource wraps an oauth2.TokenSource and fires onRotate whenever
+// Token() returns a token that differs from the last one observed.
+type notifyingSource struct {
+	ctx      context.Context
+	base     oauth2.TokenSource
+	onRotate func(*oauth2.Token)
+
+	mu   sync.Mutex
+	last string
+}
+
+func (s *notifyingSource) Token() (*oauth2.Token, error) {
+	tok, err := s.base.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	rotated := tok.AccessToken != s.last
+	s.last = tok.AccessToken
+	s.mu.Unlock()
+
+	if rotated {
+		s.onRotate(tok)
+	}
+	return tok, nil
+}
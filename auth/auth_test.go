@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+type fakeSource struct {
+	tokens []*oauth2.Token
+	i      int
+}
+
+func (f *fakeSource) Token() (*oauth2.Token, error) {
+	tok := f.tokens[f.i]
+	if f.i < len(f.tokens)-1 {
+		f.i++
+	}
+	return tok, nil
+}
+
+func TestNotifyingSource_FiresOnlyOnRotation(t *testing.T) {
+	fake := &fakeSource{tokens: []*oauth2.Token{
+		{AccessToken: "a", Expiry: time.Now().Add(time.Hour)},
+		{AccessToken: "a", Expiry: time.Now().Add(time.Hour)},
+		{AccessToken: "b", Expiry: time.Now().Add(time.Hour)},
+	}}
+
+	var rotations []string
+	src := &notifyingSource{base: fake, onRotate: func(tok *oauth2.Token) {
+		rotations = append(rotations, tok.AccessToken)
+	}}
+
+	_, err := src.Token()
+	assert.NoError(t, err)
+	_, err = src.Token()
+	assert.NoError(t, err)
+	_, err = src.Token()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"a", "b"}, rotations)
+}
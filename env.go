@@ -0,0 +1,10 @@
+package ucode_sdk
+
+import "github.com/joho/godotenv"
+
+// LoadEnv loads variables from a .env file at path into the process
+// environment. It is a thin convenience wrapper so callers don't need to
+// import godotenv directly just to bootstrap local development.
+func LoadEnv(path string) error {
+	return godotenv.Load(path)
+}
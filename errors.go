@@ -0,0 +1,25 @@
+package ucode_sdk
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPolicyDenied is returned by GetSingle when the active policy (see
+// WithPolicy) denies access to the requested record.
+var ErrPolicyDenied = errors.New("ucode_sdk: record denied by policy")
+
+// ErrNoToken is returned by accessToken when an AuthProvider is configured
+// but hasn't yet obtained a token via ExchangeCode, ClientCredentials, or
+// WithTokenSource.
+var ErrNoToken = errors.New("ucode_sdk: auth provider has no token yet")
+
+// APIError represents a non-2xx response from the ucode.io REST API.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("ucode_sdk: request failed with status %d: %s", e.StatusCode, e.Message)
+}
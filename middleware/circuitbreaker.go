@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by CircuitBreaker.Allow when the breaker has
+// tripped and calls are being short-circuited.
+var ErrCircuitOpen = errors.New("middleware: circuit open")
+
+type breakerState int
+
+const (
+	stateClosed breakerState = iota
+	stateOpen
+	stateHalfOpen
+)
+
+// CircuitBreakerConfig tunes when a CircuitBreaker trips and recovers.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures, within
+	// Window, that trips the breaker.
+	FailureThreshold int
+	// Window bounds how long consecutive failures are counted over;
+	// a success or a gap longer than Window resets the streak.
+	Window time.Duration
+	// CoolDown is how long the breaker stays open before allowing a
+	// single trial request through (half-open).
+	CoolDown time.Duration
+}
+
+// DefaultCircuitBreakerConfig trips after 5 consecutive failures within
+// 10s and probes again after a 30s cool-down.
+var DefaultCircuitBreakerConfig = CircuitBreakerConfig{
+	FailureThreshold: 5,
+	Window:           10 * time.Second,
+	CoolDown:         30 * time.Second,
+}
+
+// CircuitBreaker short-circuits calls to a failing dependency so retries
+// don't pile up against an endpoint that is already down.
+type CircuitBreaker struct {
+	cfg CircuitBreakerConfig
+
+	mu          sync.Mutex
+	state       breakerState
+	failures    int
+	lastFailure time.Time
+	openedAt    time.Time
+}
+
+// NewCircuitBreaker returns a closed CircuitBreaker using cfg.
+func NewCircuitBreaker(cfg CircuitBreakerConfig) *CircuitBreaker {
+	return &CircuitBreaker{cfg: cfg}
+}
+
+// Allow reports whether a call should proceed. It returns ErrCircuitOpen
+// if the breaker is open and still within its cool-down period.
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if time.Since(b.openedAt) < b.cfg.CoolDown {
+			return ErrCircuitOpen
+		}
+		b.state = stateHalfOpen
+		return nil
+	case stateHalfOpen:
+		// A trial request is already in flight; short-circuit everyone
+		// else until RecordSuccess/RecordFailure resolves it.
+		return ErrCircuitOpen
+	default:
+		return nil
+	}
+}
+
+// RecordSuccess closes the breaker and resets the failure streak.
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = stateClosed
+	b.failures = 0
+}
+
+// RecordFailure counts a failure, tripping the breaker open once
+// FailureThreshold consecutive failures land within Window.
+func (b *CircuitBreaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if b.failures > 0 && now.Sub(b.lastFailure) > b.cfg.Window {
+		b.failures = 0
+	}
+	b.failures++
+	b.lastFailure = now
+
+	if b.state == stateHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.state = stateOpen
+		b.openedAt = now
+	}
+}
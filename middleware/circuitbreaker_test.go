@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker_TripsAfterThreshold(t *testing.T) {
+	cb := NewCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 3,
+		Window:           time.Second,
+		CoolDown:         50 * time.Millisecond,
+	})
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, cb.Allow())
+		cb.RecordFailure()
+	}
+	require.NoError(t, cb.Allow())
+	cb.RecordFailure()
+
+	assert.ErrorIs(t, cb.Allow(), ErrCircuitOpen)
+}
+
+func TestCircuitBreaker_HalfOpensAfterCoolDown(t *testing.T) {
+	cb := NewCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 1,
+		Window:           time.Second,
+		CoolDown:         10 * time.Millisecond,
+	})
+
+	cb.RecordFailure()
+	assert.ErrorIs(t, cb.Allow(), ErrCircuitOpen)
+
+	time.Sleep(20 * time.Millisecond)
+	assert.NoError(t, cb.Allow())
+
+	cb.RecordSuccess()
+	assert.NoError(t, cb.Allow())
+}
+
+func TestCircuitBreaker_HalfOpenAdmitsOnlyOneProbe(t *testing.T) {
+	cb := NewCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 1,
+		Window:           time.Second,
+		CoolDown:         10 * time.Millisecond,
+	})
+
+	cb.RecordFailure()
+	time.Sleep(20 * time.Millisecond)
+
+	require.NoError(t, cb.Allow())
+	assert.ErrorIs(t, cb.Allow(), ErrCircuitOpen)
+	assert.ErrorIs(t, cb.Allow(), ErrCircuitOpen)
+
+	cb.RecordSuccess()
+	assert.NoError(t, cb.Allow())
+}
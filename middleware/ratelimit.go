@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter is a per-endpoint token-bucket limiter.
+type RateLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+	rps      rate.Limit
+	burst    int
+}
+
+// NewRateLimiter returns a RateLimiter allowing rps requests per second
+// per endpoint, with burst as the bucket size.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		limiters: make(map[string]*rate.Limiter),
+		rps:      rate.Limit(rps),
+		burst:    burst,
+	}
+}
+
+// Wait blocks until endpoint's bucket has a token available or ctx is
+// cancelled.
+func (r *RateLimiter) Wait(ctx context.Context, endpoint string) error {
+	return r.limiterFor(endpoint).Wait(ctx)
+}
+
+func (r *RateLimiter) limiterFor(endpoint string) *rate.Limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if l, ok := r.limiters[endpoint]; ok {
+		return l
+	}
+	l := rate.NewLimiter(r.rps, r.burst)
+	r.limiters[endpoint] = l
+	return l
+}
@@ -0,0 +1,85 @@
+// Package middleware provides resilience policies — retry with backoff,
+// rate limiting, and circuit breaking — that wrap the transport used by
+// the ucode_sdk client for both HTTP and MQTT reconnects.
+package middleware
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures exponential backoff with full jitter for
+// transient failures (429, 5xx, network errors).
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries, including the first.
+	MaxAttempts int
+	// BaseDelay is the starting backoff delay, doubled each attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff before jitter is applied.
+	MaxDelay time.Duration
+	// Deadline bounds the total time spent retrying, regardless of
+	// MaxAttempts. Zero means no deadline.
+	Deadline time.Duration
+}
+
+// DefaultRetryPolicy is a conservative policy suitable for most REST calls.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   200 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+	Deadline:    30 * time.Second,
+}
+
+// Delay returns the full-jitter backoff duration for the given attempt
+// (0-indexed).
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	backoff := float64(p.BaseDelay) * math.Pow(2, float64(attempt))
+	if max := float64(p.MaxDelay); backoff > max {
+		backoff = max
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// Retryable reports whether err or resp warrant another attempt.
+func Retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// Do runs fn, retrying per policy until it succeeds, the attempts are
+// exhausted, the deadline elapses, or ctx is cancelled.
+func Do(ctx context.Context, policy RetryPolicy, fn func() (*http.Response, error)) (*http.Response, error) {
+	if policy.Deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.Deadline)
+		defer cancel()
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		resp, err = fn()
+		if !Retryable(resp, err) {
+			return resp, err
+		}
+		if attempt == policy.MaxAttempts-1 {
+			break
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(policy.Delay(attempt)):
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		}
+	}
+	return resp, err
+}
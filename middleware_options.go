@@ -0,0 +1,21 @@
+package ucode_sdk
+
+import "github.com/ucode-io/ucode_sdk/middleware"
+
+// WithRetry enables retry-with-backoff for REST calls and MQTT reconnects.
+func WithRetry(policy middleware.RetryPolicy) Option {
+	return func(c *Config) { c.retryPolicy = &policy }
+}
+
+// WithRateLimit caps outbound REST calls to rps requests per second per
+// endpoint, with burst as the token-bucket size.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Config) { c.rateLimiter = middleware.NewRateLimiter(rps, burst) }
+}
+
+// WithCircuitBreaker trips REST calls with ErrCircuitOpen after cfg's
+// failure threshold is hit within cfg's window, short-circuiting further
+// calls until the cool-down elapses.
+func WithCircuitBreaker(cfg middleware.CircuitBreakerConfig) Option {
+	return func(c *Config) { c.breaker = middleware.NewCircuitBreaker(cfg) }
+}
@@ -0,0 +1,78 @@
+package ucode_sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/ucode-io/ucode_sdk/middleware"
+)
+
+// newMQTTClient builds and connects a paho MQTT client for the given config.
+// When cfg carries a retry policy (see WithRetry), MQTT reconnects reuse its
+// backoff bounds so REST and MQTT back off consistently.
+func newMQTTClient(cfg Config) (mqtt.Client, error) {
+	policy := middleware.DefaultRetryPolicy
+	if cfg.retryPolicy != nil {
+		policy = *cfg.retryPolicy
+	}
+
+	opts := mqtt.NewClientOptions().
+		AddBroker(cfg.MQTTBrokerURL).
+		SetClientID(fmt.Sprintf("ucode_sdk-%s", cfg.AppID)).
+		SetUsername(cfg.AppID).
+		SetPassword(cfg.Token).
+		SetAutoReconnect(true).
+		SetConnectRetryInterval(policy.BaseDelay).
+		SetMaxReconnectInterval(policy.MaxDelay)
+
+	client := mqtt.NewClient(opts)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		return nil, token.Error()
+	}
+	return client, nil
+}
+
+// reconnectMQTT re-establishes the MQTT connection using a freshly rotated
+// access token, so long-lived subscribers stay authenticated without the
+// caller having to notice the rotation.
+func (c *Client) reconnectMQTT(accessToken string) {
+	old := c.getMQTTClient()
+	if old == nil {
+		return
+	}
+	old.Disconnect(250)
+
+	cfg := c.cfg
+	cfg.Token = accessToken
+	client, err := newMQTTClient(cfg)
+	if err != nil {
+		return
+	}
+	c.setMQTTClient(client)
+}
+
+// Publish sends payload to the given MQTT topic.
+//
+// Trace context is not propagated onto the wire here: paho.mqtt.golang
+// only speaks MQTT 3.1.1, which has no user-properties mechanism, so
+// cross-service correlation for MQTT is limited to the span this call
+// creates. REST calls (see doJSON) do propagate traceparent over HTTP
+// headers.
+func (c *Client) Publish(ctx context.Context, topic string, payload []byte) error {
+	ctx, span := c.startSpan(ctx, "Publish", topic)
+	start := time.Now()
+
+	client := c.getMQTTClient()
+	if client == nil {
+		err := fmt.Errorf("ucode_sdk: mqtt client not configured")
+		c.recordResult(ctx, span, "Publish", topic, start, 0, err)
+		return err
+	}
+	token := client.Publish(topic, 1, false, payload)
+	token.Wait()
+	err := token.Error()
+	c.recordResult(ctx, span, "Publish", topic, start, 0, err)
+	return err
+}
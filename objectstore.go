@@ -0,0 +1,47 @@
+package ucode_sdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// WithObjectStore configures the StorageProvider used by Client.Upload.
+// It accepts anything satisfying objectStoreProvider (which
+// objectstore.StorageProvider implementations do) so importing this
+// package doesn't pull in the objectstore package's AWS SDK dependency
+// unless the caller also imports objectstore to construct a provider.
+func WithObjectStore(store objectStoreProvider) Option {
+	return func(c *Config) { c.objectStore = store }
+}
+
+// Upload streams r to the configured StorageProvider under a key derived
+// from tableSlug/id/fieldName, then PATCHes the object so fieldName holds
+// the resulting URL.
+func (c *Client) Upload(ctx context.Context, tableSlug, id, fieldName string, r io.Reader) (string, error) {
+	if c.cfg.objectStore == nil {
+		return "", fmt.Errorf("ucode_sdk: no object store configured, see WithObjectStore")
+	}
+
+	key := fmt.Sprintf("%s/%s/%s", tableSlug, id, fieldName)
+	url, err := c.cfg.objectStore.Upload(ctx, key, r)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := c.Update(ctx, tableSlug, id, map[string]any{fieldName: url}); err != nil {
+		return "", fmt.Errorf("ucode_sdk: saving uploaded field: %w", err)
+	}
+	return url, nil
+}
+
+// PresignDownload returns a time-limited URL for downloading the object
+// stored under tableSlug/id/fieldName.
+func (c *Client) PresignDownload(ctx context.Context, tableSlug, id, fieldName string, ttl time.Duration) (string, error) {
+	if c.cfg.objectStore == nil {
+		return "", fmt.Errorf("ucode_sdk: no object store configured, see WithObjectStore")
+	}
+	key := fmt.Sprintf("%s/%s/%s", tableSlug, id, fieldName)
+	return c.cfg.objectStore.PresignGet(ctx, key, ttl)
+}
@@ -0,0 +1,83 @@
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// LocalStore is a StorageProvider backed by a directory on disk, useful
+// for local development or tests where no S3-compatible endpoint is
+// available. Presigned URLs are not time-limited since there is no
+// server to enforce expiry; the TTL is accepted for interface parity and
+// ignored.
+type LocalStore struct {
+	Dir     string
+	BaseURL string
+}
+
+// NewLocalStore returns a LocalStore rooted at dir, creating it if needed.
+func NewLocalStore(dir, baseURL string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("objectstore: creating local store dir: %w", err)
+	}
+	return &LocalStore{Dir: dir, BaseURL: baseURL}, nil
+}
+
+// Upload writes r to Dir/key and returns a BaseURL-relative URL.
+func (s *LocalStore) Upload(ctx context.Context, key string, r io.Reader) (string, error) {
+	path, err := s.resolve(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return "", fmt.Errorf("objectstore: writing %q: %w", key, err)
+	}
+
+	u, err := url.JoinPath(s.BaseURL, key)
+	if err != nil {
+		return "", err
+	}
+	return u, nil
+}
+
+// PresignGet returns the same URL Upload would have returned, since local
+// files have no expiry to enforce.
+func (s *LocalStore) PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if _, err := s.resolve(key); err != nil {
+		return "", err
+	}
+	return url.JoinPath(s.BaseURL, key)
+}
+
+// resolve joins key onto Dir and rejects any key that would escape Dir
+// (e.g. via ".." segments), since key is attacker-influenced in
+// multi-tenant callers (see Client.Upload in the root ucode_sdk package,
+// which builds it from tableSlug/id/fieldName).
+func (s *LocalStore) resolve(key string) (string, error) {
+	root, err := filepath.Abs(s.Dir)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(root, filepath.FromSlash(key))
+	if path != root && !strings.HasPrefix(path, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("objectstore: key %q escapes store directory", key)
+	}
+	return path, nil
+}
@@ -0,0 +1,49 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore_UploadWritesFileAndReturnsURL(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewLocalStore(dir, "https://files.example.com")
+	require.NoError(t, err)
+
+	url, err := store.Upload(context.Background(), "orders/1/invoice.pdf", strings.NewReader("pdf-bytes"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://files.example.com/orders/1/invoice.pdf", url)
+
+	data, err := os.ReadFile(filepath.Join(dir, "orders", "1", "invoice.pdf"))
+	require.NoError(t, err)
+	assert.Equal(t, "pdf-bytes", string(data))
+}
+
+func TestLocalStore_PresignGetMatchesUpload(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewLocalStore(dir, "https://files.example.com")
+	require.NoError(t, err)
+
+	uploadURL, err := store.Upload(context.Background(), "a/b.txt", io.MultiReader())
+	require.NoError(t, err)
+
+	presigned, err := store.PresignGet(context.Background(), "a/b.txt", 0)
+	require.NoError(t, err)
+	assert.Equal(t, uploadURL, presigned)
+}
+
+func TestLocalStore_UploadRejectsPathTraversal(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewLocalStore(dir, "https://files.example.com")
+	require.NoError(t, err)
+
+	_, err = store.Upload(context.Background(), "../../../etc/cron.d/evil", strings.NewReader("x"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+// Package objectstore provides pluggable storage backends for the
+// file/attachment fields found on many ucode.io schemas. The default
+// implementation streams to any S3-compatible bucket (AWS, MinIO, etc.)
+// via aws-sdk-go-v2; callers needing a different backend (local disk,
+// GCS) can implement StorageProvider instead.
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// StorageProvider abstracts the operations the SDK needs from an object
+// store. Implementations must be safe for concurrent use.
+type StorageProvider interface {
+	// Upload streams r to key and returns a URL the caller can persist
+	// back onto the record's file field.
+	Upload(ctx context.Context, key string, r io.Reader) (url string, err error)
+	// PresignGet returns a time-limited URL for downloading key.
+	PresignGet(ctx context.Context, key string, ttl time.Duration) (url string, err error)
+}
+
+// S3Store is a StorageProvider backed by an S3-compatible bucket.
+type S3Store struct {
+	Bucket string
+	// PublicBaseURL, if set, is used to build Upload's returned URL
+	// instead of the default virtual-hosted-style S3 URL (e.g. for a
+	// CDN or MinIO endpoint exposed under a different host).
+	PublicBaseURL string
+
+	client   *s3.Client
+	uploader *manager.Uploader
+	presign  *s3.PresignClient
+}
+
+// NewS3Store builds an S3Store from an AWS config (see config.LoadDefaultConfig),
+// which already carries credentials, region, and optional custom endpoint
+// resolution for S3-compatible providers like MinIO.
+func NewS3Store(cfg aws.Config, bucket string) *S3Store {
+	client := s3.NewFromConfig(cfg)
+	return &S3Store{
+		Bucket:   bucket,
+		client:   client,
+		uploader: manager.NewUploader(client),
+		presign:  s3.NewPresignClient(client),
+	}
+}
+
+// Upload streams r to the bucket under key using the multipart manager,
+// so large files are split automatically, and returns the object's URL.
+func (s *S3Store) Upload(ctx context.Context, key string, r io.Reader) (string, error) {
+	_, err := s.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(key),
+		Body:   r,
+	})
+	if err != nil {
+		return "", fmt.Errorf("objectstore: upload %q: %w", key, err)
+	}
+
+	if s.PublicBaseURL != "" {
+		return fmt.Sprintf("%s/%s", s.PublicBaseURL, key), nil
+	}
+	return fmt.Sprintf("https://%s.s3.amazonaws.com/%s", s.Bucket, key), nil
+}
+
+// PresignGet returns a presigned GET URL for key valid for ttl.
+func (s *S3Store) PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	req, err := s.presign.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(ttl))
+	if err != nil {
+		return "", fmt.Errorf("objectstore: presign %q: %w", key, err)
+	}
+	return req.URL, nil
+}
@@ -0,0 +1,68 @@
+package ucode_sdk
+
+import (
+	"context"
+
+	"github.com/ucode-io/ucode_sdk/policy"
+)
+
+var defaultPolicies = policy.NewRegistry()
+
+// RegisterPolicy compiles expr as a CEL expression and registers it under
+// name so it can be applied to GetList/GetSingle results for tableSlug via
+// WithPolicy.
+func RegisterPolicy(tableSlug, name, expr string) error {
+	return defaultPolicies.Register(name, tableSlug, expr)
+}
+
+type policyCtxKey struct{}
+type authClaimsCtxKey struct{}
+
+// WithPolicy returns a context that causes subsequent GetList/GetSingle
+// calls made with it to filter and redact their results using the named
+// policy registered via RegisterPolicy.
+func WithPolicy(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, policyCtxKey{}, name)
+}
+
+// WithAuthClaims attaches the caller's auth claims (e.g. decoded from a
+// JWT) so policy expressions can read them as request.auth.
+func WithAuthClaims(ctx context.Context, claims map[string]any) context.Context {
+	return context.WithValue(ctx, authClaimsCtxKey{}, claims)
+}
+
+func policyNameFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(policyCtxKey{}).(string)
+	return name, ok
+}
+
+// applyPolicy filters out records the policy denies and redacts masked
+// fields from the remainder. The auth claims attached via WithAuthClaims
+// are forwarded as request.auth so expressions can test
+// request.auth.roles and similar. tableSlug must match the table the
+// policy was registered for (see RegisterPolicy); otherwise Evaluate
+// refuses the policy rather than silently applying it to the wrong table.
+func applyPolicy(ctx context.Context, tableSlug string, records []map[string]any) ([]map[string]any, error) {
+	name, ok := policyNameFromContext(ctx)
+	if !ok {
+		return records, nil
+	}
+
+	auth, _ := ctx.Value(authClaimsCtxKey{}).(map[string]any)
+	request := map[string]any{"auth": auth}
+	filtered := make([]map[string]any, 0, len(records))
+	for _, record := range records {
+		decision, err := defaultPolicies.Evaluate(name, tableSlug, record, request)
+		if err != nil {
+			return nil, err
+		}
+		if !decision.Allow {
+			continue
+		}
+		for _, field := range decision.Redact {
+			delete(record, field)
+		}
+		filtered = append(filtered, record)
+	}
+	return filtered, nil
+}
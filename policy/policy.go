@@ -0,0 +1,158 @@
+// Package policy lets callers attach CEL (github.com/google/cel-go)
+// expressions to ucode_sdk table slugs and evaluate them locally against
+// each record returned by GetList/GetSingle, without a remote policy
+// decision point.
+//
+// Every expression is bound to a `resource` variable holding the record's
+// fields and a `request` variable holding the caller's auth context, e.g.:
+//
+//	resource.status == "active" && "admin" in request.auth.roles
+//
+// Expressions may call the custom `mask(fields...)` function to redact
+// individual fields instead of (or alongside) allowing the record through.
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// Decision is the result of evaluating a policy against one record.
+type Decision struct {
+	// Allow reports whether the record should be included in the result set.
+	Allow bool
+	// Redact lists field names that should be stripped from the record
+	// before it is returned to the caller.
+	Redact []string
+}
+
+// Policy is a compiled CEL expression bound to a table slug.
+type Policy struct {
+	TableSlug  string
+	Expression string
+	program    cel.Program
+}
+
+// Registry holds compiled policies keyed by name.
+type Registry struct {
+	mu       sync.RWMutex
+	policies map[string]*Policy
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{policies: make(map[string]*Policy)}
+}
+
+// Register compiles expr and stores it under name for later evaluation
+// against records of tableSlug.
+func (r *Registry) Register(name, tableSlug, expr string) error {
+	env, err := newEnv()
+	if err != nil {
+		return fmt.Errorf("policy: building CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return fmt.Errorf("policy: compiling %q: %w", name, issues.Err())
+	}
+
+	prg, err := env.Program(ast)
+	if err != nil {
+		return fmt.Errorf("policy: building program for %q: %w", name, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.policies[name] = &Policy{TableSlug: tableSlug, Expression: expr, program: prg}
+	return nil
+}
+
+// ErrTableMismatch is returned by Evaluate when the named policy was
+// registered for a different table slug than the one being queried.
+var ErrTableMismatch = errors.New("policy: registered for a different table")
+
+// Evaluate runs the named policy against resource, bound with the given
+// request auth context (typically {"auth": {"roles": [...], ...}}). It
+// returns ErrTableMismatch if the policy was registered for a table slug
+// other than tableSlug, so a policy scoped to "orders" can never be
+// applied to a query against "employees" just because a caller happens to
+// pass its name.
+func (r *Registry) Evaluate(name, tableSlug string, resource map[string]any, request map[string]any) (Decision, error) {
+	r.mu.RLock()
+	p, ok := r.policies[name]
+	r.mu.RUnlock()
+	if !ok {
+		return Decision{}, fmt.Errorf("policy: no policy registered as %q", name)
+	}
+	if p.TableSlug != tableSlug {
+		return Decision{}, fmt.Errorf("policy: %q is registered for table %q, not %q: %w", name, p.TableSlug, tableSlug, ErrTableMismatch)
+	}
+
+	out, _, err := p.program.Eval(map[string]any{
+		"resource": resource,
+		"request":  request,
+	})
+	if err != nil {
+		return Decision{}, fmt.Errorf("policy: evaluating %q: %w", name, err)
+	}
+
+	return toDecision(out)
+}
+
+func toDecision(out ref.Val) (Decision, error) {
+	switch v := out.Value().(type) {
+	case bool:
+		return Decision{Allow: v}, nil
+	case []string:
+		return Decision{Allow: true, Redact: v}, nil
+	case []ref.Val:
+		fields := make([]string, 0, len(v))
+		for _, elem := range v {
+			fields = append(fields, fmt.Sprintf("%v", elem.Value()))
+		}
+		return Decision{Allow: true, Redact: fields}, nil
+	default:
+		return Decision{}, fmt.Errorf("policy: expression must evaluate to bool or mask(...), got %T", out.Value())
+	}
+}
+
+// maskMaxArity bounds how many field names mask(...) accepts. CEL has no
+// native support for variadic function overloads, so we register one
+// fixed-arity overload per supported argument count.
+const maskMaxArity = 6
+
+// newEnv builds the CEL environment shared by every policy: a `resource`
+// and `request` variable of dynamic map type, plus the mask() function.
+func newEnv() (*cel.Env, error) {
+	opts := []cel.EnvOption{
+		cel.Variable("resource", cel.DynType),
+		cel.Variable("request", cel.DynType),
+	}
+
+	for n := 1; n <= maskMaxArity; n++ {
+		argTypes := make([]*cel.Type, n)
+		for i := range argTypes {
+			argTypes[i] = cel.StringType
+		}
+		opts = append(opts, cel.Function(
+			"mask",
+			cel.Overload(fmt.Sprintf("mask_%d_string", n), argTypes, cel.ListType(cel.StringType),
+				cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+					fields := make([]string, 0, len(args))
+					for _, a := range args {
+						fields = append(fields, fmt.Sprintf("%v", a.Value()))
+					}
+					return types.NewStringList(types.DefaultTypeAdapter, fields)
+				}),
+			),
+		))
+	}
+
+	return cel.NewEnv(opts...)
+}
@@ -0,0 +1,56 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_Evaluate_AllowDeny(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register("active-only", "orders", `resource.status == "active"`))
+
+	decision, err := r.Evaluate("active-only", "orders", map[string]any{"status": "active"}, nil)
+	require.NoError(t, err)
+	assert.True(t, decision.Allow)
+
+	decision, err = r.Evaluate("active-only", "orders", map[string]any{"status": "archived"}, nil)
+	require.NoError(t, err)
+	assert.False(t, decision.Allow)
+}
+
+func TestRegistry_Evaluate_RolesFromRequest(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register("admin-only", "orders",
+		`resource.status == "active" && "admin" in request.auth.roles`))
+
+	request := map[string]any{"auth": map[string]any{"roles": []string{"admin"}}}
+	decision, err := r.Evaluate("admin-only", "orders", map[string]any{"status": "active"}, request)
+	require.NoError(t, err)
+	assert.True(t, decision.Allow)
+
+	request = map[string]any{"auth": map[string]any{"roles": []string{"viewer"}}}
+	decision, err = r.Evaluate("admin-only", "orders", map[string]any{"status": "active"}, request)
+	require.NoError(t, err)
+	assert.False(t, decision.Allow)
+}
+
+func TestRegistry_Evaluate_TableMismatch(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register("active-only", "orders", `resource.status == "active"`))
+
+	_, err := r.Evaluate("active-only", "employees", map[string]any{"status": "active"}, nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrTableMismatch)
+}
+
+func TestRegistry_Evaluate_Mask(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register("redact-ssn", "employees", `mask("ssn", "salary")`))
+
+	decision, err := r.Evaluate("redact-ssn", "employees", map[string]any{"ssn": "123-45-6789"}, nil)
+	require.NoError(t, err)
+	assert.True(t, decision.Allow)
+	assert.ElementsMatch(t, []string{"ssn", "salary"}, decision.Redact)
+}
@@ -0,0 +1,164 @@
+package ucode_sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ucode-io/ucode_sdk/middleware"
+)
+
+// GetList fetches all objects of tableSlug matching the given filter.
+func (c *Client) GetList(ctx context.Context, tableSlug string, filter map[string]any) ([]map[string]any, error) {
+	var out struct {
+		Data []map[string]any `json:"data"`
+	}
+	if err := c.doJSON(ctx, "GetList", tableSlug, http.MethodPost, "/v1/object/"+tableSlug+"/list", filter, &out); err != nil {
+		return nil, err
+	}
+	return applyPolicy(ctx, tableSlug, out.Data)
+}
+
+// GetSingle fetches a single object of tableSlug by id.
+func (c *Client) GetSingle(ctx context.Context, tableSlug, id string) (map[string]any, error) {
+	var out struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := c.doJSON(ctx, "GetSingle", tableSlug, http.MethodGet, "/v1/object/"+tableSlug+"/"+id, nil, &out); err != nil {
+		return nil, err
+	}
+
+	filtered, err := applyPolicy(ctx, tableSlug, []map[string]any{out.Data})
+	if err != nil {
+		return nil, err
+	}
+	if len(filtered) == 0 {
+		return nil, ErrPolicyDenied
+	}
+	return filtered[0], nil
+}
+
+// Create inserts a new object into tableSlug.
+func (c *Client) Create(ctx context.Context, tableSlug string, body map[string]any) (map[string]any, error) {
+	var out struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := c.doJSON(ctx, "Create", tableSlug, http.MethodPost, "/v1/object/"+tableSlug, body, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+// Update patches an existing object in tableSlug by id.
+func (c *Client) Update(ctx context.Context, tableSlug, id string, body map[string]any) (map[string]any, error) {
+	var out struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := c.doJSON(ctx, "Update", tableSlug, http.MethodPatch, "/v1/object/"+tableSlug+"/"+id, body, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+func (c *Client) doJSON(ctx context.Context, operation, tableSlug, method, path string, body any, out any) error {
+	ctx, span := c.startSpan(ctx, operation, tableSlug)
+	start := time.Now()
+
+	var rawBody []byte
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			c.recordResult(ctx, span, operation, tableSlug, start, 0, err)
+			return err
+		}
+		rawBody = b
+	}
+
+	if c.cfg.rateLimiter != nil {
+		if err := c.cfg.rateLimiter.Wait(ctx, tableSlug+":"+operation); err != nil {
+			c.recordResult(ctx, span, operation, tableSlug, start, 0, err)
+			return err
+		}
+	}
+	// Fetch the token before Allow: a half-open breaker admits one probe,
+	// and returning after Allow without recording an outcome would leave
+	// it half-open.
+	token, err := c.accessToken()
+	if err != nil {
+		c.recordResult(ctx, span, operation, tableSlug, start, 0, err)
+		return err
+	}
+
+	if c.cfg.breaker != nil {
+		if err := c.cfg.breaker.Allow(); err != nil {
+			c.recordResult(ctx, span, operation, tableSlug, start, 0, err)
+			return err
+		}
+	}
+
+	policy := middleware.DefaultRetryPolicy
+	if c.cfg.retryPolicy != nil {
+		policy = *c.cfg.retryPolicy
+	} else {
+		policy.MaxAttempts = 1
+	}
+
+	attempt := 0
+	resp, err := middleware.Do(ctx, policy, func() (*http.Response, error) {
+		if attempt > 0 {
+			c.recordRetry(ctx)
+		}
+		attempt++
+
+		var reqBody io.Reader
+		if rawBody != nil {
+			reqBody = bytes.NewReader(rawBody)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, c.cfg.BaseURL+path, reqBody)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		injectTraceContext(ctx, req.Header)
+		return c.httpClient.Do(req)
+	})
+
+	if c.cfg.breaker != nil {
+		if err != nil || (resp != nil && resp.StatusCode >= 500) {
+			c.cfg.breaker.RecordFailure()
+		} else {
+			c.cfg.breaker.RecordSuccess()
+		}
+	}
+	if err != nil {
+		c.recordResult(ctx, span, operation, tableSlug, start, 0, err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.recordResult(ctx, span, operation, tableSlug, start, resp.StatusCode, err)
+		return err
+	}
+
+	if resp.StatusCode >= 400 {
+		err := &APIError{StatusCode: resp.StatusCode, Message: string(respBody)}
+		c.recordResult(ctx, span, operation, tableSlug, start, resp.StatusCode, err)
+		return err
+	}
+
+	if out == nil || len(respBody) == 0 {
+		c.recordResult(ctx, span, operation, tableSlug, start, resp.StatusCode, nil)
+		return nil
+	}
+	err = json.Unmarshal(respBody, out)
+	c.recordResult(ctx, span, operation, tableSlug, start, resp.StatusCode, err)
+	return err
+}
@@ -0,0 +1,157 @@
+// Package ucode_sdk is the official Go client for the ucode.io platform.
+//
+// A Client is constructed via New and exposes CRUD helpers over the REST
+// API, MQTT publishing, and a WebSocket subscription (Subscribe) for
+// real-time change notifications.
+package ucode_sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/ucode-io/ucode_sdk/middleware"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
+)
+
+// Config holds everything needed to talk to a ucode.io environment.
+type Config struct {
+	BaseURL string
+	AppID   string
+	Token   string
+
+	MQTTBrokerURL string
+
+	HTTPClient *http.Client
+	Timeout    time.Duration
+
+	authProvider AuthProvider
+	objectStore  objectStoreProvider
+	retryPolicy  *middleware.RetryPolicy
+	rateLimiter  *middleware.RateLimiter
+	breaker      *middleware.CircuitBreaker
+
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// objectStoreProvider mirrors objectstore.StorageProvider. It is declared
+// here, rather than imported directly, to keep the root package free of
+// the objectstore package's AWS SDK dependency unless a caller opts in.
+type objectStoreProvider interface {
+	Upload(ctx context.Context, key string, r io.Reader) (string, error)
+	PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// Option mutates a Config at construction time.
+type Option func(*Config)
+
+// WithTimeout overrides the default HTTP client timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Config) { c.Timeout = d }
+}
+
+// WithHTTPClient lets callers supply a preconfigured *http.Client.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Config) { c.HTTPClient = hc }
+}
+
+// AuthProvider is satisfied by auth.Provider. It is declared here rather
+// than imported directly so the root package stays free of the auth
+// package's OIDC dependencies unless a caller opts in.
+type AuthProvider interface {
+	TokenSource() oauth2.TokenSource
+	OnRotate(func(*oauth2.Token))
+}
+
+// WithAuthProvider authenticates the client with an OIDC/OAuth2 provider
+// instead of the static Config.Token. REST calls use the provider's
+// current access token, and MQTT connections are refreshed automatically
+// whenever the token rotates.
+func WithAuthProvider(p AuthProvider) Option {
+	return func(c *Config) { c.authProvider = p }
+}
+
+// Client is the entry point for all SDK operations.
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+	telemetry  *telemetry
+
+	mqttMu     sync.Mutex
+	mqttClient mqtt.Client
+}
+
+// getMQTTClient returns the current MQTT client, if any, safe for
+// concurrent use with reconnectMQTT (which runs from the auth provider's
+// OnRotate callback, potentially while a Publish is in flight).
+func (c *Client) getMQTTClient() mqtt.Client {
+	c.mqttMu.Lock()
+	defer c.mqttMu.Unlock()
+	return c.mqttClient
+}
+
+func (c *Client) setMQTTClient(client mqtt.Client) {
+	c.mqttMu.Lock()
+	defer c.mqttMu.Unlock()
+	c.mqttClient = client
+}
+
+// New builds a Client from the given base configuration and options.
+func New(cfg Config, opts ...Option) (*Client, error) {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	c := &Client{
+		cfg:        cfg,
+		httpClient: httpClient,
+		telemetry:  newTelemetry(cfg.tracerProvider, cfg.meterProvider),
+	}
+
+	if cfg.MQTTBrokerURL != "" {
+		client, err := newMQTTClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		c.mqttClient = client
+	}
+
+	if cfg.authProvider != nil {
+		cfg.authProvider.OnRotate(func(tok *oauth2.Token) {
+			c.reconnectMQTT(tok.AccessToken)
+		})
+	}
+
+	return c, nil
+}
+
+// accessToken returns the token to send with outbound requests, preferring
+// a live OIDC token over the static Config.Token.
+func (c *Client) accessToken() (string, error) {
+	if c.cfg.authProvider == nil {
+		return c.cfg.Token, nil
+	}
+	src := c.cfg.authProvider.TokenSource()
+	if src == nil {
+		return "", ErrNoToken
+	}
+	tok, err := src.Token()
+	if err != nil {
+		return "", err
+	}
+	return tok.AccessToken, nil
+}
@@ -0,0 +1,222 @@
+package ucode_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// subscriberBufferSize sizes each listener's Event channel. A listener that
+// falls behind has events dropped for it rather than blocking delivery to
+// other listeners or stalling the socket reader.
+const subscriberBufferSize = 64
+
+// EventType identifies the kind of change a subscription Event carries.
+type EventType string
+
+const (
+	EventCreate EventType = "create"
+	EventUpdate EventType = "update"
+	EventDelete EventType = "delete"
+)
+
+// Event is a single change notification for a subscribed table.
+type Event struct {
+	Type      EventType      `json:"type"`
+	TableSlug string         `json:"table_slug"`
+	Cursor    string         `json:"cursor"`
+	Data      map[string]any `json:"data"`
+}
+
+// Subscribe opens a WebSocket connection to ucode.io's change-stream
+// endpoint for tableSlug and returns a Subscription matching filter. The
+// connection automatically reconnects with exponential backoff and jitter,
+// replaying from the last seen cursor so no events are lost. Cancelling ctx
+// closes the subscription and every channel handed out by Listen.
+func (c *Client) Subscribe(ctx context.Context, tableSlug string, filter map[string]any) (*Subscription, error) {
+	s := &Subscription{
+		client:    c,
+		tableSlug: tableSlug,
+		filter:    filter,
+		listeners: make(map[chan Event]struct{}),
+	}
+	go s.run(ctx)
+
+	return s, nil
+}
+
+// Subscription fans events out to any number of listeners: each call to
+// Listen gets its own channel, and a listener that stalls only drops events
+// for itself — it never blocks delivery to other listeners or the
+// underlying socket reader.
+type Subscription struct {
+	client    *Client
+	tableSlug string
+	filter    map[string]any
+	cursor    string
+
+	mu        sync.Mutex
+	listeners map[chan Event]struct{}
+	closed    bool
+}
+
+// Listen returns a new channel of Events for this subscription. It may be
+// called any number of times, including concurrently, to add independent
+// consumers; each gets its own buffered channel so one slow consumer never
+// blocks another. The channel is closed once the subscription's context is
+// cancelled.
+func (s *Subscription) Listen() <-chan Event {
+	ch := make(chan Event, subscriberBufferSize)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		close(ch)
+		return ch
+	}
+	s.listeners[ch] = struct{}{}
+	return ch
+}
+
+// broadcast delivers evt to every registered listener without blocking: a
+// listener whose buffer is full has this event dropped for it rather than
+// stalling the other listeners or the socket reader.
+func (s *Subscription) broadcast(evt Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch := range s.listeners {
+		select {
+		case ch <- evt:
+		default:
+		}
+	}
+}
+
+// closeListeners closes every channel handed out by Listen and marks the
+// subscription closed so later Listen calls return an already-closed
+// channel instead of leaking one nobody will ever read.
+func (s *Subscription) closeListeners() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch := range s.listeners {
+		close(ch)
+		delete(s.listeners, ch)
+	}
+	s.closed = true
+}
+
+func (s *Subscription) run(ctx context.Context) {
+	defer s.closeListeners()
+
+	attempt := 0
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if attempt > 0 {
+			s.client.recordRetry(ctx)
+		}
+
+		if err := s.connectAndStream(ctx); err != nil {
+			attempt++
+			select {
+			case <-time.After(backoffWithJitter(attempt)):
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+
+		// connectAndStream returns nil only once ctx is cancelled, which
+		// the check at the top of the loop handles. Any read error,
+		// including the server closing the socket, takes the backoff
+		// path above, so long-lived subscribers keep reconnecting.
+		attempt = 0
+	}
+}
+
+func (s *Subscription) connectAndStream(ctx context.Context) error {
+	spanCtx, span := s.client.startSpan(ctx, "Subscribe", s.tableSlug)
+	start := time.Now()
+	var err error
+	defer func() { s.client.recordResult(spanCtx, span, "Subscribe", s.tableSlug, start, 0, err) }()
+
+	wsURL, buildErr := s.buildURL()
+	if buildErr != nil {
+		err = buildErr
+		return err
+	}
+
+	header := http.Header{}
+	injectTraceContext(spanCtx, header)
+	conn, _, dialErr := websocket.DefaultDialer.DialContext(spanCtx, wsURL, header)
+	if dialErr != nil {
+		err = fmt.Errorf("ucode_sdk: websocket dial failed: %w", dialErr)
+		return err
+	}
+	defer conn.Close()
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	for {
+		var evt Event
+		if readErr := conn.ReadJSON(&evt); readErr != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			err = readErr
+			return err
+		}
+
+		s.cursor = evt.Cursor
+		s.broadcast(evt)
+	}
+}
+
+func (s *Subscription) buildURL() (string, error) {
+	base := s.client.cfg.BaseURL
+	base = strings.Replace(base, "https://", "wss://", 1)
+	base = strings.Replace(base, "http://", "ws://", 1)
+
+	filterJSON, err := json.Marshal(s.filter)
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{}
+	q.Set("filter", string(filterJSON))
+	if s.cursor != "" {
+		q.Set("cursor", s.cursor)
+	}
+	if token, err := s.client.accessToken(); err == nil && token != "" {
+		q.Set("access_token", token)
+	}
+
+	return fmt.Sprintf("%s/v1/subscribe/%s?%s", base, s.tableSlug, q.Encode()), nil
+}
+
+// backoffWithJitter returns a reconnect delay that grows exponentially
+// with attempt, capped at 30s, with full jitter to avoid thundering-herd
+// reconnects against the server.
+func backoffWithJitter(attempt int) time.Duration {
+	const max = 30 * time.Second
+	base := time.Duration(1<<uint(min(attempt, 5))) * time.Second
+	if base > max {
+		base = max
+	}
+	return time.Duration(rand.Int63n(int64(base) + 1))
+}
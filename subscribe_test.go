@@ -0,0 +1,41 @@
+package ucode_sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffWithJitter_CapsAtMax(t *testing.T) {
+	for attempt := 0; attempt < 20; attempt++ {
+		d := backoffWithJitter(attempt)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, 30*time.Second)
+	}
+}
+
+func TestSubscription_BroadcastDoesNotBlockOnSlowListener(t *testing.T) {
+	s := &Subscription{listeners: make(map[chan Event]struct{})}
+	slow := s.Listen() // never drained
+	fast := s.Listen()
+
+	// Drain fast in lockstep with each broadcast so its buffer never
+	// fills; this isolates the behavior under test to the slow listener.
+	const sent = subscriberBufferSize * 3
+	for i := 0; i < sent; i++ {
+		done := make(chan struct{})
+		go func() {
+			s.broadcast(Event{Cursor: "evt"})
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatalf("broadcast blocked on a full listener at iteration %d", i)
+		}
+		<-fast
+	}
+
+	assert.Len(t, slow, subscriberBufferSize)
+}
@@ -0,0 +1,123 @@
+package ucode_sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const instrumentationName = "github.com/ucode-io/ucode_sdk"
+
+// telemetry bundles the tracer, meter, and instruments used to observe
+// every outbound REST call, MQTT publish/subscribe, and websocket
+// subscription. It defaults to OpenTelemetry's no-op providers so there
+// is zero overhead unless a caller opts in via WithTracerProvider /
+// WithMeterProvider.
+type telemetry struct {
+	tracer trace.Tracer
+	meter  metric.Meter
+
+	duration metric.Float64Histogram
+	retries  metric.Int64Counter
+	errors   metric.Int64Counter
+}
+
+func newTelemetry(tp trace.TracerProvider, mp metric.MeterProvider) *telemetry {
+	if tp == nil {
+		tp = tracenoop.NewTracerProvider()
+	}
+	if mp == nil {
+		mp = noop.NewMeterProvider()
+	}
+
+	meter := mp.Meter(instrumentationName)
+	duration, _ := meter.Float64Histogram("ucode.request.duration",
+		metric.WithDescription("Duration of ucode_sdk operations"), metric.WithUnit("s"))
+	retries, _ := meter.Int64Counter("ucode.request.retries",
+		metric.WithDescription("Number of retry attempts made by ucode_sdk operations"))
+	errs, _ := meter.Int64Counter("ucode.request.errors",
+		metric.WithDescription("Number of ucode_sdk operations that returned an error"))
+
+	return &telemetry{
+		tracer:   tp.Tracer(instrumentationName),
+		meter:    meter,
+		duration: duration,
+		retries:  retries,
+		errors:   errs,
+	}
+}
+
+// startSpan starts a span for a single SDK operation, tagged with the
+// table slug, operation name, and configured app id.
+func (c *Client) startSpan(ctx context.Context, operation, tableSlug string) (context.Context, trace.Span) {
+	return c.telemetry.tracer.Start(ctx, "ucode_sdk."+operation, trace.WithAttributes(
+		attribute.String("ucode.operation", operation),
+		attribute.String("ucode.table", tableSlug),
+		attribute.String("ucode.app_id", c.cfg.AppID),
+	))
+}
+
+// recordResult finishes an instrumented operation: it stamps the span
+// with the HTTP status (if any) and error, and records the duration
+// histogram and error counter tagged with the operation and table so they
+// can be broken down the same way the span can. Retries are counted
+// separately by recordRetry.
+func (c *Client) recordResult(ctx context.Context, span trace.Span, operation, tableSlug string, start time.Time, statusCode int, err error) {
+	defer span.End()
+
+	attrs := []attribute.KeyValue{
+		attribute.String("ucode.operation", operation),
+		attribute.String("ucode.table", tableSlug),
+	}
+	if statusCode != 0 {
+		attrs = append(attrs, attribute.Int("http.status_code", statusCode))
+		span.SetAttributes(attribute.Int("http.status_code", statusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.telemetry.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+	c.telemetry.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+}
+
+// recordRetry increments the retry counter; called once per extra attempt
+// beyond the first for a single operation.
+func (c *Client) recordRetry(ctx context.Context) {
+	c.telemetry.retries.Add(ctx, 1)
+}
+
+// injectTraceContext propagates the span context from ctx into outbound
+// HTTP headers (traceparent/tracestate) so traces stay connected across
+// ucode.io backend services. W3C trace context is always written; any
+// globally registered propagator (otel.SetTextMapPropagator) is applied
+// as well.
+func injectTraceContext(ctx context.Context, header http.Header) {
+	propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		otel.GetTextMapPropagator(),
+	).Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// WithTracerProvider sets the trace.TracerProvider used to create spans
+// for REST, MQTT, and websocket operations. Unset, spans are created from
+// a no-op provider, matching the no-op default for WithMeterProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *Config) { c.tracerProvider = tp }
+}
+
+// WithMeterProvider sets the metric.MeterProvider used to record request
+// duration, retry, and error instruments. Unset, metrics are recorded
+// against a no-op meter.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *Config) { c.meterProvider = mp }
+}
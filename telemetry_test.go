@@ -0,0 +1,20 @@
+package ucode_sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartSpanAndRecordResult_NoopProvidersDontPanic(t *testing.T) {
+	c, err := New(Config{BaseURL: "http://unused.invalid"})
+	assert.NoError(t, err)
+
+	ctx, span := c.startSpan(context.Background(), "GetList", "orders")
+	c.recordResult(ctx, span, "GetList", "orders", time.Now(), 200, nil)
+	c.recordResult(ctx, span, "GetList", "orders", time.Now(), 500, errors.New("boom"))
+	c.recordRetry(ctx)
+}
@@ -0,0 +1,73 @@
+package ucode_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ucode-io/ucode_sdk/validation"
+)
+
+// CreateRequest wraps a typed payload for Create, validated against its
+// `validate` struct tags before the SDK marshals and sends it.
+type CreateRequest[T any] struct {
+	Payload T
+}
+
+// UpdateRequest wraps a typed payload for Update, validated against its
+// `validate` struct tags before the SDK marshals and sends it.
+type UpdateRequest[T any] struct {
+	ID      string
+	Payload T
+}
+
+// ValidationError is re-exported so callers don't need to import the
+// validation subpackage just to inspect field errors.
+type ValidationError = validation.ValidationError
+
+// RegisterValidator registers a custom `validate` tag (e.g.
+// ucode_object_id, ucode_slug) usable by CreateTyped/UpdateTyped payloads
+// across any table.
+func RegisterValidator(tag string, fn validation.ValidatorFunc) error {
+	return validation.RegisterValidator(tag, fn)
+}
+
+// CreateTyped validates req.Payload and, if valid, creates it in
+// tableSlug. Validation failures are returned as *ValidationError.
+func CreateTyped[T any](ctx context.Context, c *Client, tableSlug string, req CreateRequest[T]) (map[string]any, error) {
+	if err := validation.Struct(req.Payload); err != nil {
+		return nil, err
+	}
+	body, err := toMap(req.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return c.Create(ctx, tableSlug, body)
+}
+
+// UpdateTyped validates req.Payload and, if valid, patches req.ID in
+// tableSlug. Validation failures are returned as *ValidationError.
+func UpdateTyped[T any](ctx context.Context, c *Client, tableSlug string, req UpdateRequest[T]) (map[string]any, error) {
+	if err := validation.Struct(req.Payload); err != nil {
+		return nil, err
+	}
+	body, err := toMap(req.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return c.Update(ctx, tableSlug, req.ID, body)
+}
+
+// toMap round-trips v through JSON so typed payloads can be sent through
+// the same map[string]any-based REST helpers as untyped calls.
+func toMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("ucode_sdk: marshaling payload: %w", err)
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("ucode_sdk: payload must marshal to a JSON object: %w", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,27 @@
+package ucode_sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testOrderPayload struct {
+	Status string `json:"status" validate:"required,oneof=active archived"`
+}
+
+func TestCreateTyped_ReturnsValidationErrorWithoutSending(t *testing.T) {
+	c, err := New(Config{BaseURL: "http://unused.invalid"})
+	require.NoError(t, err)
+
+	_, err = CreateTyped(context.Background(), c, "orders", CreateRequest[testOrderPayload]{
+		Payload: testOrderPayload{Status: "unknown"},
+	})
+
+	require.Error(t, err)
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Contains(t, verr.Fields(), "Status")
+}
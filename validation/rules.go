@@ -0,0 +1,23 @@
+package validation
+
+import (
+	"regexp"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// objectIDPattern matches ucode.io's 24-character hex object ids (Mongo
+// ObjectID format).
+var objectIDPattern = regexp.MustCompile(`^[a-f0-9]{24}$`)
+
+// slugPattern matches ucode.io table/field slugs: lowercase, digits, and
+// underscores, starting with a lowercase letter.
+var slugPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+func isObjectID(fl validator.FieldLevel) bool {
+	return objectIDPattern.MatchString(fl.Field().String())
+}
+
+func isSlug(fl validator.FieldLevel) bool {
+	return slugPattern.MatchString(fl.Field().String())
+}
@@ -0,0 +1,84 @@
+// Package validation validates request payloads against struct tags
+// before the SDK marshals and sends them, using
+// github.com/go-playground/validator/v10. It also exposes a small
+// registry so callers can register custom validators (e.g.
+// ucode_object_id, ucode_slug) once and reuse them across projects.
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var std = validator.New()
+
+// ValidatorFunc is re-exported so callers registering custom validators
+// don't need to import go-playground/validator directly.
+type ValidatorFunc = validator.Func
+
+func init() {
+	// register(...) panics are acceptable here: these are fixed,
+	// well-formed tag names known at compile time.
+	register("ucode_object_id", isObjectID)
+	register("ucode_slug", isSlug)
+}
+
+// ValidationError reports every struct-tag violation found in a payload,
+// grouped by field, so callers can surface them back to an HTTP handler.
+type ValidationError struct {
+	fields map[string][]string
+}
+
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("validation: ")
+	first := true
+	for field, msgs := range e.fields {
+		if !first {
+			sb.WriteString("; ")
+		}
+		first = false
+		fmt.Fprintf(&sb, "%s: %s", field, strings.Join(msgs, ", "))
+	}
+	return sb.String()
+}
+
+// Fields returns the violations keyed by struct field name.
+func (e *ValidationError) Fields() map[string][]string {
+	return e.fields
+}
+
+// Struct validates v against its `validate` struct tags, returning a
+// *ValidationError describing every violation, or nil if v is valid.
+func Struct(v any) error {
+	err := std.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	verrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return err
+	}
+
+	fields := make(map[string][]string, len(verrs))
+	for _, fe := range verrs {
+		fields[fe.Field()] = append(fields[fe.Field()], fe.Tag())
+	}
+	return &ValidationError{fields: fields}
+}
+
+// RegisterValidator adds a custom validation tag usable by any struct
+// validated via Struct, so it can be shared across projects instead of
+// being redefined per call site.
+func RegisterValidator(tag string, fn ValidatorFunc) error {
+	return std.RegisterValidation(tag, fn)
+}
+
+func register(tag string, fn validator.Func) {
+	if err := std.RegisterValidation(tag, fn); err != nil {
+		panic(fmt.Sprintf("validation: registering %q: %v", tag, err))
+	}
+}
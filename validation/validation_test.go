@@ -0,0 +1,28 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type order struct {
+	ID     string `validate:"required,ucode_object_id"`
+	Status string `validate:"required,oneof=active archived"`
+}
+
+func TestStruct_ReturnsValidationErrorWithFields(t *testing.T) {
+	err := Struct(order{ID: "not-an-id", Status: "unknown"})
+	require.Error(t, err)
+
+	verr, ok := err.(*ValidationError)
+	require.True(t, ok)
+	assert.Contains(t, verr.Fields(), "ID")
+	assert.Contains(t, verr.Fields(), "Status")
+}
+
+func TestStruct_ValidPayloadPasses(t *testing.T) {
+	err := Struct(order{ID: "507f1f77bcf86cd799439011", Status: "active"})
+	assert.NoError(t, err)
+}